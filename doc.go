@@ -7,11 +7,18 @@ patterns, event notification systems, and coordinating goroutines.
 
 • Thread-safe: All operations are protected by read-write mutexes
 • Context-aware: Automatic cleanup when contexts are canceled
-• Non-blocking broadcasts: Broadcasts never block, even if subscribers are slow
-• Buffered channels: Each subscriber gets a buffered channel to prevent blocking
+• Configurable backpressure: HubConfig controls subscriber limits, buffer depth,
+and whether Broadcast drops, waits, or blocks on a slow subscriber
+• Topic-keyed pub/sub: SubscribeTopic/BroadcastTopic turn a single Hub into a
+bus for unrelated event streams
+• Typed broadcasts: Feed[T] delivers arbitrary values with guaranteed
+FIFO ordering across subscribers, not just empty signals
 • Automatic cleanup: Subscribers are automatically removed when their context is canceled
+• Resilient subscriptions: Resubscribe/ResubscribeFeed retry a flaky upstream
+subscription behind a single stable channel
+• Stream combinators: Merge, Debounce, Throttle, Filter and Map compose
+subscription channels without hand-rolled fan-in/timing code
 • Zero dependencies: Uses only the Go standard library
-• High performance: Optimized for concurrent access and minimal overhead
 
 # Basic Usage
 
@@ -20,7 +27,10 @@ patterns, event notification systems, and coordinating goroutines.
 	defer cancel()
 
 	// Subscribe to the hub
-	ch := hub.Subscribe(ctx)
+	ch, err := hub.Subscribe(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Listen for signals in a goroutine
 	go func() {
@@ -37,6 +47,47 @@ patterns, event notification systems, and coordinating goroutines.
 	// Broadcast a signal to all subscribers
 	hub.Broadcast()
 
+Subscribe only returns an error once HubConfig.SubscriberLimit is in effect
+and has been reached; callers that know their Hub has no limit can use
+MustSubscribe instead and skip the error check.
+
+# Configuration
+
+NewWithConfig creates a Hub with non-default backpressure behavior:
+
+	hub := chanhub.NewWithConfig(chanhub.HubConfig{
+		SubscriberLimit:  1000,
+		BufferSize:       16,
+		BroadcastTimeout: 50 * time.Millisecond,
+	})
+
+hub.Len() reports the current number of active subscribers, which is useful
+for observing backpressure against SubscriberLimit.
+
+# Topics
+
+A single Hub can also serve as an in-process pub/sub bus keyed by topic:
+
+	cfgCh, _ := hub.SubscribeTopic(ctx, "config")
+	hub.BroadcastTopic("config") // only reaches "config" and wildcard subscribers
+
+Subscribers registered with the plain Subscribe are wildcard subscribers: they
+receive both Broadcast and every BroadcastTopic, regardless of topic.
+
+# Typed Feeds
+
+Feed[T] is the typed counterpart to Hub, for broadcasting values instead of
+bare signals, with guaranteed delivery order:
+
+	feed := chanhub.NewFeed[int]()
+	ch := make(chan int, 1)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	feed.Send(42)
+
+A SubscriptionScope tracks a set of Subscriptions so a component with many
+feeds can tear them all down with a single scope.Close().
+
 # Use Cases
 
 Event Notification Systems:
@@ -59,6 +110,25 @@ Real-time Updates:
 Implement server-sent events or WebSocket broadcasting for real-time web
 applications.
 
+# Resubscribing to Flaky Sources
+
+Resubscribe wraps a subscription function that can fail or go stale and
+re-exposes it as one stable channel, retrying with exponential backoff:
+
+	ch := chanhub.Resubscribe(ctx, 5*time.Second, func(ctx context.Context) (<-chan struct{}, error) {
+		return watchConfigFile(ctx) // may fail or its channel may close early
+	})
+
+ResubscribeFeed does the same for a typed source.
+
+# Stream Combinators
+
+Merge, Debounce, Throttle, Filter and Map treat subscription channels as
+composable streams:
+
+	updates := chanhub.Debounce(hub.MustSubscribe(ctx), 200*time.Millisecond)
+	evens := chanhub.Filter(intFeedCh, func(v int) bool { return v%2 == 0 })
+
 # Thread Safety
 
 All methods of Hub are safe for concurrent use. Multiple goroutines can
@@ -73,7 +143,9 @@ while allowing concurrent reads during broadcasts.
 # Performance Characteristics
 
 • Subscribe: O(1) operation
-• Broadcast: O(n) where n is the number of subscribers, but non-blocking
+• Broadcast: O(n) where n is the number of subscribers; non-blocking by
+default, or bounded/blocking per subscriber when HubConfig.BroadcastTimeout
+is set
 • Memory overhead: Minimal - only channel references stored in a map
 • Cleanup: Automatic with no manual intervention required
 
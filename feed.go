@@ -0,0 +1,201 @@
+package chanhub
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Subscription represents a stream of events delivered by a Feed. The
+// subscriber must read the channel it supplied to Subscribe; Unsubscribe
+// stops delivery and Err reports when the subscription has ended.
+type Subscription interface {
+	// Unsubscribe cancels the subscription. It can be called any number
+	// of times.
+	Unsubscribe()
+	// Err returns a channel that is closed when the subscription ends.
+	// It never carries a value: the only thing that matters is the close.
+	Err() <-chan error
+}
+
+// feedSub is the Subscription returned by Feed.Subscribe.
+type feedSub struct {
+	unsub   func(*feedSub)
+	channel reflect.Value
+	once    sync.Once
+	err     chan error
+}
+
+func (s *feedSub) Unsubscribe() {
+	s.once.Do(func() {
+		s.unsub(s)
+		close(s.err)
+	})
+}
+
+func (s *feedSub) Err() <-chan error {
+	return s.err
+}
+
+// Feed delivers typed values to a set of subscriber channels, modeled on
+// go-ethereum's event.Feed. Unlike Hub.Broadcast, which by default drops a
+// signal a slow subscriber hasn't yet consumed, Feed.Send guarantees FIFO ordering:
+// if Send(a) happens-before Send(b), every subscriber observes a before b.
+// The zero value is not usable; create one with NewFeed.
+type Feed[T any] struct {
+	// Timeout bounds how long a single Send call waits on a slow
+	// subscriber before moving on. Zero (the default) waits forever.
+	Timeout time.Duration
+
+	sendLock chan struct{} // held for the duration of a Send call
+	mu       sync.Mutex
+	subs     map[*feedSub]struct{}
+}
+
+// NewFeed creates an empty Feed ready for Subscribe and Send.
+func NewFeed[T any]() *Feed[T] {
+	return &Feed[T]{
+		sendLock: make(chan struct{}, 1),
+		subs:     make(map[*feedSub]struct{}),
+	}
+}
+
+// Subscribe registers ch to receive values sent on the feed and returns a
+// Subscription that can be used to stop delivery. ch should have adequate
+// buffer space for slow consumers, or accept that a slow consumer can delay
+// Send up to Feed.Timeout.
+func (f *Feed[T]) Subscribe(ch chan<- T) Subscription {
+	sub := &feedSub{
+		unsub:   f.remove,
+		channel: reflect.ValueOf(ch),
+		err:     make(chan error, 1),
+	}
+	f.mu.Lock()
+	f.subs[sub] = struct{}{}
+	f.mu.Unlock()
+	return sub
+}
+
+func (f *Feed[T]) remove(sub *feedSub) {
+	f.mu.Lock()
+	delete(f.subs, sub)
+	f.mu.Unlock()
+}
+
+// Send delivers v to every currently subscribed channel, in FIFO order with
+// respect to other Send calls, and returns the number of subscribers it
+// reached. A subscriber that is still blocking on a previous send keeps
+// others waiting only up to Feed.Timeout; once that elapses, Send returns
+// without delivering to the subscribers it hasn't reached yet.
+func (f *Feed[T]) Send(v T) int {
+	f.mu.Lock()
+	subs := make([]*feedSub, 0, len(f.subs))
+	for sub := range f.subs {
+		subs = append(subs, sub)
+	}
+	f.mu.Unlock()
+
+	if len(subs) == 0 {
+		return 0
+	}
+
+	f.sendLock <- struct{}{}
+	defer func() { <-f.sendLock }()
+
+	rvalue := reflect.ValueOf(v)
+	cases := make([]reflect.SelectCase, len(subs))
+	for i, sub := range subs {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: sub.channel, Send: rvalue}
+	}
+
+	var timeout <-chan time.Time
+	if f.Timeout > 0 {
+		timer := time.NewTimer(f.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	delivered := 0
+	for len(cases) > 0 {
+		selCases := cases
+		timeoutIdx := -1
+		if timeout != nil {
+			timeoutIdx = len(cases)
+			selCases = append(append([]reflect.SelectCase(nil), cases...),
+				reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timeout)})
+		}
+		chosen, _, _ := reflect.Select(selCases)
+		if chosen == timeoutIdx {
+			break
+		}
+		cases = append(cases[:chosen], cases[chosen+1:]...)
+		delivered++
+	}
+	return delivered
+}
+
+// SubscriptionScope batches a set of Subscriptions so they can all be ended
+// with a single Close call, which makes shutting down a component that
+// holds many feed subscriptions a one-liner.
+type SubscriptionScope struct {
+	mu     sync.Mutex
+	subs   map[*scopeSub]struct{}
+	closed bool
+}
+
+type scopeSub struct {
+	sc *SubscriptionScope
+	s  Subscription
+}
+
+func (s *scopeSub) Unsubscribe() {
+	s.sc.mu.Lock()
+	delete(s.sc.subs, s)
+	s.sc.mu.Unlock()
+	s.s.Unsubscribe()
+}
+
+func (s *scopeSub) Err() <-chan error {
+	return s.s.Err()
+}
+
+// Track adds s to the scope, so a later Close will unsubscribe it. If the
+// scope has already been closed, s is unsubscribed immediately and Track
+// returns nil. The returned Subscription is equivalent to s but also
+// removes itself from the scope when unsubscribed directly.
+func (sc *SubscriptionScope) Track(s Subscription) Subscription {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.closed {
+		s.Unsubscribe()
+		return nil
+	}
+	if sc.subs == nil {
+		sc.subs = make(map[*scopeSub]struct{})
+	}
+	ss := &scopeSub{sc: sc, s: s}
+	sc.subs[ss] = struct{}{}
+	return ss
+}
+
+// Close unsubscribes every Subscription tracked by the scope. Further calls
+// to Track will unsubscribe immediately instead of adding to the scope.
+func (sc *SubscriptionScope) Close() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.closed {
+		return
+	}
+	sc.closed = true
+	for s := range sc.subs {
+		s.s.Unsubscribe()
+	}
+	sc.subs = nil
+}
+
+// Count returns the number of Subscriptions currently tracked by the scope.
+func (sc *SubscriptionScope) Count() int {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return len(sc.subs)
+}
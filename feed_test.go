@@ -0,0 +1,170 @@
+package chanhub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFeed_SubscribeAndSend(t *testing.T) {
+	feed := NewFeed[int]()
+
+	ch := make(chan int, 1)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	n := feed.Send(42)
+	if n != 1 {
+		t.Fatalf("Send() = %d, want 1", n)
+	}
+
+	select {
+	case v := <-ch:
+		if v != 42 {
+			t.Fatalf("got %d, want 42", v)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("value not received within timeout")
+	}
+}
+
+func TestFeed_SendNoSubscribers(t *testing.T) {
+	feed := NewFeed[string]()
+
+	n := feed.Send("hello")
+	if n != 0 {
+		t.Fatalf("Send() = %d, want 0", n)
+	}
+}
+
+func TestFeed_SendOrderAcrossSubscribers(t *testing.T) {
+	feed := NewFeed[int]()
+
+	const numSubs = 4
+	chans := make([]chan int, numSubs)
+	for i := range chans {
+		chans[i] = make(chan int, 3)
+		defer feed.Subscribe(chans[i]).Unsubscribe()
+	}
+
+	for _, v := range []int{1, 2, 3} {
+		feed.Send(v)
+	}
+
+	for i, ch := range chans {
+		for _, want := range []int{1, 2, 3} {
+			select {
+			case got := <-ch:
+				if got != want {
+					t.Fatalf("subscriber %d: got %d, want %d", i, got, want)
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatalf("subscriber %d: value %d not received within timeout", i, want)
+			}
+		}
+	}
+}
+
+func TestFeed_Unsubscribe(t *testing.T) {
+	feed := NewFeed[int]()
+
+	ch := make(chan int, 1)
+	sub := feed.Subscribe(ch)
+	sub.Unsubscribe()
+
+	select {
+	case <-sub.Err():
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Err() channel was not closed after Unsubscribe")
+	}
+
+	n := feed.Send(1)
+	if n != 0 {
+		t.Fatalf("Send() = %d, want 0 after unsubscribe", n)
+	}
+}
+
+func TestFeed_SendTimeoutSkipsSlowSubscriber(t *testing.T) {
+	feed := NewFeed[int]()
+	feed.Timeout = 10 * time.Millisecond
+
+	slow := make(chan int) // unbuffered, nobody reads it
+	fast := make(chan int, 1)
+	defer feed.Subscribe(slow).Unsubscribe()
+	defer feed.Subscribe(fast).Unsubscribe()
+
+	done := make(chan int)
+	go func() { done <- feed.Send(7) }()
+
+	select {
+	case n := <-done:
+		if n != 1 {
+			t.Fatalf("Send() = %d, want 1 (only the fast subscriber)", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Send() did not honor Timeout")
+	}
+
+	select {
+	case v := <-fast:
+		if v != 7 {
+			t.Fatalf("got %d, want 7", v)
+		}
+	default:
+		t.Fatal("fast subscriber did not receive the value")
+	}
+}
+
+func TestSubscriptionScope_Close(t *testing.T) {
+	feed := NewFeed[int]()
+	var scope SubscriptionScope
+
+	ch := make(chan int, 1)
+	scope.Track(feed.Subscribe(ch))
+
+	if got := scope.Count(); got != 1 {
+		t.Fatalf("Count() = %d, want 1", got)
+	}
+
+	scope.Close()
+
+	if got := scope.Count(); got != 0 {
+		t.Fatalf("Count() after Close() = %d, want 0", got)
+	}
+	if feed.Send(1) != 0 {
+		t.Fatal("subscription should have been unsubscribed by Close()")
+	}
+}
+
+func TestSubscriptionScope_TrackAfterClose(t *testing.T) {
+	feed := NewFeed[int]()
+	var scope SubscriptionScope
+	scope.Close()
+
+	ch := make(chan int, 1)
+	sub := scope.Track(feed.Subscribe(ch))
+	if sub != nil {
+		t.Fatal("Track() after Close() should return nil")
+	}
+	if feed.Send(1) != 0 {
+		t.Fatal("subscription tracked after Close() should be unsubscribed immediately")
+	}
+}
+
+func BenchmarkFeed_Send(b *testing.B) {
+	feed := NewFeed[int]()
+
+	const numSubs = 100
+	for i := 0; i < numSubs; i++ {
+		ch := make(chan int, 1)
+		defer feed.Subscribe(ch).Unsubscribe()
+		go func() {
+			for range ch {
+			}
+		}()
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		feed.Send(i)
+	}
+}
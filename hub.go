@@ -2,21 +2,91 @@ package chanhub
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"time"
 )
 
+// ErrSubscriberLimitReached is returned by Subscribe when the hub already
+// has HubConfig.SubscriberLimit active subscribers.
+var ErrSubscriberLimitReached = errors.New("chanhub: subscriber limit reached")
+
+// HubConfig configures the backpressure behavior of a Hub. The zero value
+// reproduces the original Hub semantics: unlimited subscribers, a buffer of
+// 1 per subscriber, and Broadcast dropping a signal a subscriber hasn't
+// consumed yet.
+type HubConfig struct {
+	// SubscriberLimit caps the number of concurrent subscribers. Subscribe
+	// returns ErrSubscriberLimitReached once the limit is hit. Zero means
+	// no limit.
+	SubscriberLimit int
+
+	// BufferSize sets the buffer depth of each subscriber's channel.
+	// Values <= 0 default to 1, matching the original Hub.
+	BufferSize int
+
+	// BroadcastTimeout controls how Broadcast behaves when a subscriber's
+	// channel is full:
+	//   0 (default): drop the signal for that subscriber, as before.
+	//   > 0: wait up to BroadcastTimeout for the subscriber to catch up,
+	//        then give up on it and move on.
+	//   < 0: block until the subscriber catches up.
+	BroadcastTimeout time.Duration
+}
+
+// New creates a Hub with the default configuration: unlimited subscribers,
+// a per-subscriber buffer of 1, and non-blocking Broadcast.
 func New() *Hub {
-	return &Hub{subs: make(map[chan struct{}]struct{})}
+	return NewWithConfig(HubConfig{})
 }
 
+// NewWithConfig creates a Hub with the given configuration. See HubConfig
+// for the meaning of each field.
+func NewWithConfig(cfg HubConfig) *Hub {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 1
+	}
+	return &Hub{
+		cfg:    cfg,
+		subs:   make(map[chan struct{}]struct{}),
+		topics: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Hub is a single instance that can serve both a plain broadcast (Subscribe
+// / Broadcast) and an in-process pub/sub bus keyed by topic (SubscribeTopic
+// / BroadcastTopic). subs holds the wildcard subscribers, which receive
+// every Broadcast and every BroadcastTopic regardless of topic; topics
+// holds the subscribers registered for one specific topic.
 type Hub struct {
-	mu   sync.RWMutex
-	subs map[chan struct{}]struct{}
+	cfg    HubConfig
+	mu     sync.RWMutex
+	subs   map[chan struct{}]struct{}
+	topics map[string]map[chan struct{}]struct{}
+}
+
+// totalLocked returns the number of active subscribers across both the
+// wildcard set and every topic. h.mu must be held.
+func (h *Hub) totalLocked() int {
+	n := len(h.subs)
+	for _, subs := range h.topics {
+		n += len(subs)
+	}
+	return n
 }
 
-func (h *Hub) Subscribe(ctx context.Context) <-chan struct{} {
-	ch := make(chan struct{}, 1)
+// Subscribe registers a new wildcard subscriber channel bound to ctx and
+// returns it. A wildcard subscriber receives every Broadcast and every
+// BroadcastTopic, regardless of topic. Subscribe returns
+// ErrSubscriberLimitReached if HubConfig.SubscriberLimit active subscribers
+// already exist.
+func (h *Hub) Subscribe(ctx context.Context) (<-chan struct{}, error) {
 	h.mu.Lock()
+	if h.cfg.SubscriberLimit > 0 && h.totalLocked() >= h.cfg.SubscriberLimit {
+		h.mu.Unlock()
+		return nil, ErrSubscriberLimitReached
+	}
+	ch := make(chan struct{}, h.cfg.BufferSize)
 	h.subs[ch] = struct{}{}
 	h.mu.Unlock()
 
@@ -27,16 +97,121 @@ func (h *Hub) Subscribe(ctx context.Context) <-chan struct{} {
 		h.mu.Unlock()
 		close(ch)
 	}()
+	return ch, nil
+}
+
+// SubscribeTopic registers a new subscriber channel that only receives
+// signals from BroadcastTopic calls made with the matching topic. It
+// returns ErrSubscriberLimitReached under the same conditions as Subscribe.
+func (h *Hub) SubscribeTopic(ctx context.Context, topic string) (<-chan struct{}, error) {
+	h.mu.Lock()
+	if h.cfg.SubscriberLimit > 0 && h.totalLocked() >= h.cfg.SubscriberLimit {
+		h.mu.Unlock()
+		return nil, ErrSubscriberLimitReached
+	}
+	ch := make(chan struct{}, h.cfg.BufferSize)
+	subs, ok := h.topics[topic]
+	if !ok {
+		subs = make(map[chan struct{}]struct{})
+		h.topics[topic] = subs
+	}
+	subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		delete(h.topics[topic], ch)
+		if len(h.topics[topic]) == 0 {
+			delete(h.topics, topic)
+		}
+		h.mu.Unlock()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// MustSubscribe behaves like Subscribe but panics instead of returning an
+// error. It preserves Subscribe's original signature for callers that run
+// a Hub with no SubscriberLimit, where Subscribe can never fail.
+func (h *Hub) MustSubscribe(ctx context.Context) <-chan struct{} {
+	ch, err := h.Subscribe(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return ch
 }
 
+// Broadcast signals every wildcard subscriber (those registered via
+// Subscribe). Topic-only subscribers are not notified; use BroadcastTopic
+// for those. How Broadcast handles a subscriber whose channel is still
+// full from a previous signal is governed by HubConfig.BroadcastTimeout.
 func (h *Hub) Broadcast() {
+	for _, ch := range h.snapshot(h.subs) {
+		h.send(ch)
+	}
+}
+
+// BroadcastTopic signals every subscriber registered for topic via
+// SubscribeTopic, plus every wildcard subscriber registered via Subscribe.
+func (h *Hub) BroadcastTopic(topic string) {
 	h.mu.RLock()
+	targets := make([]chan struct{}, 0, len(h.topics[topic])+len(h.subs))
+	for ch := range h.topics[topic] {
+		targets = append(targets, ch)
+	}
 	for ch := range h.subs {
+		targets = append(targets, ch)
+	}
+	h.mu.RUnlock()
+
+	for _, ch := range targets {
+		h.send(ch)
+	}
+}
+
+// snapshot copies subs into a slice while holding the read lock, so the
+// caller can send to each channel afterwards without blocking Subscribe,
+// SubscribeTopic, or the context-cancel cleanup goroutines for the
+// duration of a potentially blocking send (see HubConfig.BroadcastTimeout).
+func (h *Hub) snapshot(subs map[chan struct{}]struct{}) []chan struct{} {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	targets := make([]chan struct{}, 0, len(subs))
+	for ch := range subs {
+		targets = append(targets, ch)
+	}
+	return targets
+}
+
+// send delivers a signal to ch. The channel was read from a snapshot taken
+// under h.mu, so by the time send runs its subscriber may already have
+// unsubscribed and closed ch; recover treats that the same as a subscriber
+// that simply isn't listening anymore.
+func (h *Hub) send(ch chan struct{}) {
+	defer func() { recover() }()
+	switch {
+	case h.cfg.BroadcastTimeout == 0:
 		select {
-		case ch <- struct{}{}: // non-blocking
+		case ch <- struct{}{}:
 		default: // previous signal still pending – skip
 		}
+	case h.cfg.BroadcastTimeout > 0:
+		timer := time.NewTimer(h.cfg.BroadcastTimeout)
+		defer timer.Stop()
+		select {
+		case ch <- struct{}{}:
+		case <-timer.C: // subscriber didn't catch up in time – skip
+		}
+	default:
+		ch <- struct{}{}
 	}
-	h.mu.RUnlock()
+}
+
+// Len reports the number of currently active subscribers, wildcard and
+// topic alike.
+func (h *Hub) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.totalLocked()
 }
@@ -25,7 +25,7 @@ func TestHub_Subscribe(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	ch := hub.Subscribe(ctx)
+	ch := hub.MustSubscribe(ctx)
 	if ch == nil {
 		t.Fatal("Subscribe() returned nil channel")
 	}
@@ -44,7 +44,7 @@ func TestHub_SubscribeContextCancellation(t *testing.T) {
 	hub := New()
 	ctx, cancel := context.WithCancel(context.Background())
 
-	ch := hub.Subscribe(ctx)
+	ch := hub.MustSubscribe(ctx)
 
 	// Verify subscription exists
 	hub.mu.RLock()
@@ -91,7 +91,7 @@ func TestHub_MultipleSubscriptions(t *testing.T) {
 
 	// Create multiple subscriptions
 	for i := 0; i < numSubs; i++ {
-		channels[i] = hub.Subscribe(ctx)
+		channels[i] = hub.MustSubscribe(ctx)
 	}
 
 	// Verify all subscriptions exist
@@ -121,7 +121,7 @@ func TestHub_Broadcast(t *testing.T) {
 
 	// Create subscriptions
 	for i := 0; i < numSubs; i++ {
-		channels[i] = hub.Subscribe(ctx)
+		channels[i] = hub.MustSubscribe(ctx)
 	}
 
 	// Broadcast signal
@@ -157,7 +157,7 @@ func TestHub_BroadcastNonBlocking(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	ch := hub.Subscribe(ctx)
+	ch := hub.MustSubscribe(ctx)
 
 	// First broadcast should send signal
 	hub.Broadcast()
@@ -202,7 +202,7 @@ func TestHub_ConcurrentSubscribeAndBroadcast(t *testing.T) {
 		wg.Add(1)
 		go func(idx int) {
 			defer wg.Done()
-			ch := hub.Subscribe(ctx)
+			ch := hub.MustSubscribe(ctx)
 
 			for {
 				select {
@@ -251,7 +251,7 @@ func TestHub_ConcurrentSubscribeUnsubscribe(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			ctx, cancel := context.WithCancel(context.Background())
-			hub.Subscribe(ctx)
+			hub.MustSubscribe(ctx)
 			time.Sleep(time.Millisecond) // Brief delay
 			cancel()
 		}()
@@ -277,7 +277,7 @@ func TestHub_ChannelBuffering(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	ch := hub.Subscribe(ctx)
+	ch := hub.MustSubscribe(ctx)
 
 	// Send signal
 	hub.Broadcast()
@@ -302,6 +302,202 @@ func TestHub_ChannelBuffering(t *testing.T) {
 	}
 }
 
+func TestHub_SubscribeReturnsError(t *testing.T) {
+	hub := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := hub.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("Subscribe() unexpected error: %v", err)
+	}
+	if ch == nil {
+		t.Fatal("Subscribe() returned nil channel")
+	}
+}
+
+func TestHub_SubscriberLimit(t *testing.T) {
+	hub := NewWithConfig(HubConfig{SubscriberLimit: 2})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := hub.Subscribe(ctx); err != nil {
+		t.Fatalf("Subscribe() 1 unexpected error: %v", err)
+	}
+	if _, err := hub.Subscribe(ctx); err != nil {
+		t.Fatalf("Subscribe() 2 unexpected error: %v", err)
+	}
+
+	if _, err := hub.Subscribe(ctx); err != ErrSubscriberLimitReached {
+		t.Fatalf("Subscribe() 3 error = %v, want ErrSubscriberLimitReached", err)
+	}
+}
+
+func TestHub_BufferSize(t *testing.T) {
+	hub := NewWithConfig(HubConfig{BufferSize: 3})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := hub.MustSubscribe(ctx)
+
+	for i := 0; i < 3; i++ {
+		hub.Broadcast()
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-ch:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("signal %d not buffered", i)
+		}
+	}
+}
+
+func TestHub_BroadcastTimeoutWaitsThenDrops(t *testing.T) {
+	hub := NewWithConfig(HubConfig{BroadcastTimeout: 20 * time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := hub.MustSubscribe(ctx)
+	hub.Broadcast() // fills the buffer of 1
+
+	start := time.Now()
+	hub.Broadcast() // channel still full: should wait out the timeout then drop
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("Broadcast() returned after %v, want >= timeout", elapsed)
+	}
+
+	<-ch // drain the first signal
+	select {
+	case <-ch:
+		t.Fatal("second Broadcast() should have been dropped after timing out")
+	default:
+	}
+}
+
+func TestHub_BroadcastTimeoutBlocksIndefinitely(t *testing.T) {
+	hub := NewWithConfig(HubConfig{BroadcastTimeout: -1})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := hub.MustSubscribe(ctx)
+	hub.Broadcast() // fills the buffer of 1
+
+	done := make(chan struct{})
+	go func() {
+		hub.Broadcast() // should block until ch is drained
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Broadcast() returned before the subscriber caught up")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	<-ch // unblocks the pending Broadcast
+
+	select {
+	case <-done:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Broadcast() did not unblock after the subscriber caught up")
+	}
+}
+
+func TestHub_Len(t *testing.T) {
+	hub := New()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if got := hub.Len(); got != 0 {
+		t.Fatalf("Len() = %d, want 0", got)
+	}
+
+	hub.MustSubscribe(ctx)
+	hub.MustSubscribe(ctx)
+	if got := hub.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if got := hub.Len(); got != 0 {
+		t.Fatalf("Len() after cancellation = %d, want 0", got)
+	}
+}
+
+func TestHub_BroadcastTopic(t *testing.T) {
+	hub := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configCh, err := hub.SubscribeTopic(ctx, "config")
+	if err != nil {
+		t.Fatalf("SubscribeTopic() unexpected error: %v", err)
+	}
+	cacheCh, err := hub.SubscribeTopic(ctx, "cache")
+	if err != nil {
+		t.Fatalf("SubscribeTopic() unexpected error: %v", err)
+	}
+
+	hub.BroadcastTopic("config")
+
+	select {
+	case <-configCh:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("config subscriber did not receive signal")
+	}
+
+	select {
+	case <-cacheCh:
+		t.Fatal("cache subscriber should not have received a config signal")
+	default:
+	}
+}
+
+func TestHub_BroadcastTopicReachesWildcardSubscribers(t *testing.T) {
+	hub := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wildcard := hub.MustSubscribe(ctx)
+	topical, err := hub.SubscribeTopic(ctx, "config")
+	if err != nil {
+		t.Fatalf("SubscribeTopic() unexpected error: %v", err)
+	}
+
+	hub.BroadcastTopic("config")
+
+	select {
+	case <-wildcard:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("wildcard subscriber did not receive the topic signal")
+	}
+	select {
+	case <-topical:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("topic subscriber did not receive its own signal")
+	}
+}
+
+func TestHub_SubscribeTopicCleanup(t *testing.T) {
+	hub := New()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if _, err := hub.SubscribeTopic(ctx, "config"); err != nil {
+		t.Fatalf("SubscribeTopic() unexpected error: %v", err)
+	}
+	if got := hub.Len(); got != 1 {
+		t.Fatalf("Len() = %d, want 1", got)
+	}
+
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+
+	if got := hub.Len(); got != 0 {
+		t.Fatalf("Len() after cancellation = %d, want 0", got)
+	}
+}
+
 // Benchmark tests
 func BenchmarkHub_Subscribe(b *testing.B) {
 	hub := New()
@@ -309,7 +505,7 @@ func BenchmarkHub_Subscribe(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		hub.Subscribe(ctx)
+		hub.MustSubscribe(ctx)
 	}
 }
 
@@ -321,7 +517,7 @@ func BenchmarkHub_Broadcast(b *testing.B) {
 	// Create some subscriptions
 	const numSubs = 100
 	for i := 0; i < numSubs; i++ {
-		hub.Subscribe(ctx)
+		hub.MustSubscribe(ctx)
 	}
 
 	b.ResetTimer()
@@ -336,7 +532,7 @@ func BenchmarkHub_ConcurrentOperations(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
 			ctx, cancel := context.WithCancel(context.Background())
-			ch := hub.Subscribe(ctx)
+			ch := hub.MustSubscribe(ctx)
 			hub.Broadcast()
 			select {
 			case <-ch:
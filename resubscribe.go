@@ -0,0 +1,103 @@
+package chanhub
+
+import (
+	"context"
+	"time"
+)
+
+// resubscribeMinBackoff is the starting point for the exponential backoff
+// used by Resubscribe and ResubscribeFeed.
+const resubscribeMinBackoff = 10 * time.Millisecond
+
+// Resubscribe maintains a single stable channel backed by a repeatedly
+// (re-)established upstream subscription, so a caller can range over one
+// channel across arbitrary reconnects instead of reimplementing retry
+// logic around a flaky source (a config watcher, a network stream, ...).
+//
+// fn is called to (re-)establish the subscription. If fn returns an error,
+// or the channel it returned closes before ctx is done, Resubscribe waits
+// with exponential backoff – starting at a small interval and capped at
+// backoff – before calling fn again. A subscription that stays healthy for
+// at least backoff resets the wait to its starting point for the next
+// failure.
+//
+// The returned channel is closed once ctx is done.
+func Resubscribe(ctx context.Context, backoff time.Duration, fn func(context.Context) (<-chan struct{}, error)) <-chan struct{} {
+	return resubscribe(ctx, backoff, fn)
+}
+
+// ResubscribeFeed is the typed equivalent of Resubscribe for sources that
+// hand back a Feed subscription channel instead of a Hub's <-chan struct{}.
+func ResubscribeFeed[T any](ctx context.Context, backoff time.Duration, fn func(context.Context) (<-chan T, error)) <-chan T {
+	return resubscribe(ctx, backoff, fn)
+}
+
+func resubscribe[T any](ctx context.Context, backoff time.Duration, fn func(context.Context) (<-chan T, error)) <-chan T {
+	// A non-positive backoff isn't a meaningful cap – treat it as the
+	// starting interval instead of letting wait collapse to time.After(0)
+	// and hot-loop fn on repeated failure.
+	if backoff <= 0 {
+		backoff = resubscribeMinBackoff
+	}
+
+	out := make(chan T)
+	go func() {
+		defer close(out)
+
+		wait := minDuration(resubscribeMinBackoff, backoff)
+		for {
+			established := time.Now()
+			in, err := fn(ctx)
+			if err == nil {
+				err = forwardUntilClosed(ctx, in, out)
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil && time.Since(established) >= backoff {
+				wait = minDuration(resubscribeMinBackoff, backoff)
+			}
+
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return
+			}
+
+			if wait < backoff {
+				wait *= 2
+				if wait > backoff {
+					wait = backoff
+				}
+			}
+		}
+	}()
+	return out
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// forwardUntilClosed copies values from in to out until in closes or ctx
+// is done, returning nil in the former case and ctx.Err() in the latter.
+func forwardUntilClosed[T any](ctx context.Context, in <-chan T, out chan<- T) error {
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return nil
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
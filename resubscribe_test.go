@@ -0,0 +1,154 @@
+package chanhub
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResubscribe_ForwardsValues(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstream := make(chan struct{}, 1)
+	out := Resubscribe(ctx, 10*time.Millisecond, func(ctx context.Context) (<-chan struct{}, error) {
+		return upstream, nil
+	})
+
+	upstream <- struct{}{}
+
+	select {
+	case <-out:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("value not forwarded within timeout")
+	}
+}
+
+func TestResubscribe_RetriesAfterError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts int32
+	upstream := make(chan struct{}, 1)
+	out := Resubscribe(ctx, 5*time.Millisecond, func(ctx context.Context) (<-chan struct{}, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n <= 2 {
+			return nil, errors.New("transient failure")
+		}
+		return upstream, nil
+	})
+
+	upstream <- struct{}{}
+
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("Resubscribe did not recover from transient failures")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got < 3 {
+		t.Fatalf("fn called %d times, want at least 3", got)
+	}
+}
+
+func TestResubscribe_RetriesAfterUpstreamClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts int32
+	out := Resubscribe(ctx, 5*time.Millisecond, func(ctx context.Context) (<-chan struct{}, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		ch := make(chan struct{}, 1)
+		if n == 1 {
+			close(ch) // first subscription dies immediately
+			return ch, nil
+		}
+		ch <- struct{}{}
+		return ch, nil
+	})
+
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("Resubscribe did not reconnect after the upstream channel closed")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got < 2 {
+		t.Fatalf("fn called %d times, want at least 2", got)
+	}
+}
+
+func TestResubscribe_ClosesWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	out := Resubscribe(ctx, 10*time.Millisecond, func(ctx context.Context) (<-chan struct{}, error) {
+		ch := make(chan struct{})
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		return ch, nil
+	})
+
+	cancel()
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("out should be closed, not deliver a value")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("out was not closed after context cancellation")
+	}
+}
+
+func TestResubscribe_WaitNeverExceedsBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const backoff = 2 * time.Millisecond // smaller than resubscribeMinBackoff
+	var attempts int32
+	start := time.Now()
+
+	out := Resubscribe(ctx, backoff, func(ctx context.Context) (<-chan struct{}, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, errors.New("always fails")
+	})
+	_ = out
+
+	// Give it time for several retries; if wait were clamped to
+	// resubscribeMinBackoff (10ms) instead of backoff (2ms), far fewer
+	// attempts would land in this window.
+	time.Sleep(60 * time.Millisecond)
+	cancel()
+
+	elapsed := time.Since(start)
+	got := atomic.LoadInt32(&attempts)
+	want := int32(elapsed / backoff / 2) // generous lower bound
+	if got < want {
+		t.Fatalf("fn called %d times in %v, want at least %d (wait should be capped at backoff, not resubscribeMinBackoff)", got, elapsed, want)
+	}
+}
+
+func TestResubscribeFeed_ForwardsTypedValues(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	upstream := make(chan int, 1)
+	out := ResubscribeFeed[int](ctx, 10*time.Millisecond, func(ctx context.Context) (<-chan int, error) {
+		return upstream, nil
+	})
+
+	upstream <- 99
+
+	select {
+	case v := <-out:
+		if v != 99 {
+			t.Fatalf("got %d, want 99", v)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("value not forwarded within timeout")
+	}
+}
@@ -0,0 +1,110 @@
+package chanhub
+
+import (
+	"sync"
+	"time"
+)
+
+// Merge fans multiple signal channels into one. It mirrors how Subscribe
+// signals the end of a subscription: the merged channel closes once every
+// input channel has closed, so a caller can range over the result exactly
+// like it would over a single Subscribe channel.
+func Merge(chs ...<-chan struct{}) <-chan struct{} {
+	out := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(len(chs))
+	for _, ch := range chs {
+		go func(ch <-chan struct{}) {
+			defer wg.Done()
+			for range ch {
+				out <- struct{}{}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Debounce collapses a burst of signals on ch into a single signal on the
+// returned channel, emitted once d has elapsed without a further signal.
+// It closes its output when ch closes.
+func Debounce(ch <-chan struct{}, d time.Duration) <-chan struct{} {
+	out := make(chan struct{})
+	go func() {
+		defer close(out)
+
+		timer := time.NewTimer(d)
+		if !timer.Stop() {
+			<-timer.C
+		}
+		armed := false
+
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+				if armed && !timer.Stop() {
+					<-timer.C
+				}
+				timer.Reset(d)
+				armed = true
+			case <-timer.C:
+				out <- struct{}{}
+				armed = false
+			}
+		}
+	}()
+	return out
+}
+
+// Throttle emits at most one signal per d, dropping any signal on ch that
+// arrives before d has elapsed since the last one emitted. It closes its
+// output when ch closes.
+func Throttle(ch <-chan struct{}, d time.Duration) <-chan struct{} {
+	out := make(chan struct{})
+	go func() {
+		defer close(out)
+
+		var last time.Time
+		for range ch {
+			if now := time.Now(); last.IsZero() || now.Sub(last) >= d {
+				last = now
+				out <- struct{}{}
+			}
+		}
+	}()
+	return out
+}
+
+// Filter passes along only the values from in for which pred returns true.
+// It closes its output when in closes.
+func Filter[T any](in <-chan T, pred func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range in {
+			if pred(v) {
+				out <- v
+			}
+		}
+	}()
+	return out
+}
+
+// Map applies f to every value from in and forwards the result. It closes
+// its output when in closes.
+func Map[T, U any](in <-chan T, f func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- f(v)
+		}
+	}()
+	return out
+}
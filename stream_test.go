@@ -0,0 +1,161 @@
+package chanhub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMerge_ForwardsFromAllInputs(t *testing.T) {
+	a := make(chan struct{}, 1)
+	b := make(chan struct{}, 1)
+	out := Merge(a, b)
+
+	a <- struct{}{}
+	b <- struct{}{}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-out:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("signal %d not forwarded within timeout", i)
+		}
+	}
+}
+
+func TestMerge_ClosesWhenAllInputsClose(t *testing.T) {
+	a := make(chan struct{})
+	b := make(chan struct{})
+	out := Merge(a, b)
+
+	close(a)
+
+	select {
+	case <-out:
+		t.Fatal("out should not close until every input has closed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(b)
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("out should be closed, not deliver a value")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("out was not closed after all inputs closed")
+	}
+}
+
+func TestDebounce_CollapsesBurst(t *testing.T) {
+	in := make(chan struct{})
+	out := Debounce(in, 20*time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		in <- struct{}{}
+		time.Sleep(time.Millisecond)
+	}
+
+	select {
+	case <-out:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("debounced signal not received")
+	}
+
+	select {
+	case <-out:
+		t.Fatal("debounce should only emit once per burst")
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestDebounce_ClosesWithInput(t *testing.T) {
+	in := make(chan struct{})
+	out := Debounce(in, 20*time.Millisecond)
+
+	close(in)
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("out should be closed, not deliver a value")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("out was not closed after in closed")
+	}
+}
+
+func TestThrottle_DropsWithinWindow(t *testing.T) {
+	in := make(chan struct{}, 3)
+	out := Throttle(in, 30*time.Millisecond)
+
+	in <- struct{}{}
+	in <- struct{}{}
+
+	select {
+	case <-out:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("first signal not received")
+	}
+
+	select {
+	case <-out:
+		t.Fatal("second signal within the window should have been dropped")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestFilter(t *testing.T) {
+	in := make(chan int, 4)
+	out := Filter(in, func(v int) bool { return v%2 == 0 })
+
+	in <- 1
+	in <- 2
+	in <- 3
+	in <- 4
+	close(in)
+
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+
+	if len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Fatalf("Filter() = %v, want [2 4]", got)
+	}
+}
+
+func TestMap(t *testing.T) {
+	in := make(chan int, 3)
+	out := Map(in, func(v int) string {
+		if v == 0 {
+			return "zero"
+		}
+		return "nonzero"
+	})
+
+	in <- 0
+	in <- 1
+	close(in)
+
+	want := []string{"zero", "nonzero"}
+	for i, w := range want {
+		select {
+		case got := <-out:
+			if got != w {
+				t.Fatalf("value %d: got %q, want %q", i, got, w)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("value %d not received within timeout", i)
+		}
+	}
+
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("out should be closed after in closed")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("out was not closed after in closed")
+	}
+}